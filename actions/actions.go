@@ -0,0 +1,265 @@
+// Package actions implements the W3C WebDriver Actions API: a fluent
+// Builder that compiles key, pointer and wheel input into the payload
+// expected by POST /session/{id}/actions.
+package actions
+
+import "time"
+
+// SourceType identifies the kind of input source an ActionSequence drives.
+type SourceType string
+
+const (
+	KeySource     SourceType = "key"
+	PointerSource SourceType = "pointer"
+	WheelSource   SourceType = "wheel"
+)
+
+// PointerType selects the device a pointer input source emulates.
+type PointerType string
+
+const (
+	Mouse PointerType = "mouse"
+	Pen   PointerType = "pen"
+	Touch PointerType = "touch"
+)
+
+// Origin selects what a pointer move's coordinates are relative to.
+type Origin string
+
+const (
+	Viewport      Origin = "viewport"
+	PointerOrigin Origin = "pointer"
+)
+
+// Mouse button indices used by PointerDown/PointerUp.
+const (
+	LeftButton   = 0
+	MiddleButton = 1
+	RightButton  = 2
+)
+
+// Normalized W3C key codes for modifier and control keys.
+// Reference: https://www.w3.org/TR/webdriver/#keyboard-actions
+const (
+	KeyShift     = "\uE008"
+	KeyControl   = "\uE009"
+	KeyAlt       = "\uE00A"
+	KeyMeta      = "\uE03D"
+	KeyEnter     = "\uE007"
+	KeyTab       = "\uE004"
+	KeyBackspace = "\uE003"
+	KeyEscape    = "\uE00C"
+)
+
+// action is a single step of an ActionSequence, encoded exactly as the W3C
+// spec expects it on the wire.
+type action map[string]interface{}
+
+// pointerParameters is the "parameters" object the W3C spec attaches to
+// pointer input sources.
+type pointerParameters struct {
+	PointerType PointerType `json:"pointerType"`
+}
+
+// ActionSequence is the set of actions performed by a single input source,
+// ready to be sent to POST /session/{id}/actions.
+type ActionSequence struct {
+	ID         string      `json:"id"`
+	Type       SourceType  `json:"type"`
+	Parameters interface{} `json:"parameters,omitempty"`
+	Actions    []action    `json:"actions"`
+}
+
+// Builder assembles one ActionSequence per input source and compiles them
+// into the payload for Session.PerformActions.
+type Builder struct {
+	key     *ActionSequence
+	pointer *ActionSequence
+	wheel   *ActionSequence
+}
+
+// NewBuilder returns an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+func (b *Builder) keySeq() *ActionSequence {
+	if b.key == nil {
+		b.key = &ActionSequence{ID: "keyboard", Type: KeySource}
+	}
+
+	return b.key
+}
+
+func (b *Builder) pointerSeq() *ActionSequence {
+	if b.pointer == nil {
+		b.pointer = &ActionSequence{
+			ID:         "pointer",
+			Type:       PointerSource,
+			Parameters: pointerParameters{PointerType: Mouse},
+		}
+	}
+
+	return b.pointer
+}
+
+func (b *Builder) wheelSeq() *ActionSequence {
+	if b.wheel == nil {
+		b.wheel = &ActionSequence{ID: "wheel", Type: WheelSource}
+	}
+
+	return b.wheel
+}
+
+// UsePointerType sets the device the pointer input source emulates (Mouse,
+// Pen or Touch). It must be called before the first pointer action.
+func (b *Builder) UsePointerType(pointerType PointerType) *Builder {
+	seq := b.pointerSeq()
+	seq.Parameters = pointerParameters{PointerType: pointerType}
+
+	return b
+}
+
+// KeyDown presses down the given key without releasing it.
+func (b *Builder) KeyDown(key string) *Builder {
+	seq := b.keySeq()
+	seq.Actions = append(seq.Actions, action{"type": "keyDown", "value": key})
+
+	return b
+}
+
+// KeyUp releases the given key.
+func (b *Builder) KeyUp(key string) *Builder {
+	seq := b.keySeq()
+	seq.Actions = append(seq.Actions, action{"type": "keyUp", "value": key})
+
+	return b
+}
+
+// PointerMove moves the pointer to (x, y) relative to origin (Viewport,
+// PointerOrigin, or an element reference built by the caller) over
+// duration.
+func (b *Builder) PointerMove(
+	x, y int, origin interface{}, duration time.Duration,
+) *Builder {
+	seq := b.pointerSeq()
+	seq.Actions = append(seq.Actions, action{
+		"type":     "pointerMove",
+		"duration": duration.Milliseconds(),
+		"x":        x,
+		"y":        y,
+		"origin":   origin,
+	})
+
+	return b
+}
+
+// PointerDown presses down the given pointer button (see the *Button
+// constants).
+func (b *Builder) PointerDown(button int) *Builder {
+	seq := b.pointerSeq()
+	seq.Actions = append(
+		seq.Actions, action{"type": "pointerDown", "button": button},
+	)
+
+	return b
+}
+
+// PointerUp releases the given pointer button.
+func (b *Builder) PointerUp(button int) *Builder {
+	seq := b.pointerSeq()
+	seq.Actions = append(
+		seq.Actions, action{"type": "pointerUp", "button": button},
+	)
+
+	return b
+}
+
+// Scroll emits a wheel scroll of (deltaX, deltaY) at (x, y) relative to
+// origin.
+func (b *Builder) Scroll(x, y, deltaX, deltaY int, origin interface{}) *Builder {
+	seq := b.wheelSeq()
+	seq.Actions = append(seq.Actions, action{
+		"type":     "scroll",
+		"x":        x,
+		"y":        y,
+		"deltaX":   deltaX,
+		"deltaY":   deltaY,
+		"origin":   origin,
+		"duration": 0,
+	})
+
+	return b
+}
+
+// DragAndDrop appends a press-move-release sequence from origin from to
+// origin to (each built by the caller, e.g. an element reference), so it can
+// be composed with other actions before a single Build().
+func (b *Builder) DragAndDrop(from, to interface{}) *Builder {
+	return b.
+		PointerMove(0, 0, from, 0).
+		PointerDown(LeftButton).
+		PointerMove(0, 0, to, 200*time.Millisecond).
+		PointerUp(LeftButton)
+}
+
+// DoubleClick appends two clicks in rapid succession at origin.
+func (b *Builder) DoubleClick(origin interface{}) *Builder {
+	b.PointerMove(0, 0, origin, 0)
+
+	for i := 0; i < 2; i++ {
+		b.PointerDown(LeftButton).PointerUp(LeftButton)
+	}
+
+	return b
+}
+
+// TypeWithModifiers appends key presses for text while holding down the
+// given modifier keys (e.g. KeyShift) for its duration.
+func (b *Builder) TypeWithModifiers(text string, modifiers ...string) *Builder {
+	for _, mod := range modifiers {
+		b.KeyDown(mod)
+	}
+
+	for _, r := range text {
+		b.KeyDown(string(r)).KeyUp(string(r))
+	}
+
+	for _, mod := range modifiers {
+		b.KeyUp(mod)
+	}
+
+	return b
+}
+
+// Pause inserts a no-op of duration in every input source already used by
+// the builder, keeping them in lockstep.
+func (b *Builder) Pause(duration time.Duration) *Builder {
+	ms := duration.Milliseconds()
+
+	for _, seq := range []*ActionSequence{b.key, b.pointer, b.wheel} {
+		if seq == nil {
+			continue
+		}
+
+		seq.Actions = append(
+			seq.Actions, action{"type": "pause", "duration": ms},
+		)
+	}
+
+	return b
+}
+
+// Build compiles the builder into the ActionSequence list expected by
+// Session.PerformActions.
+func (b *Builder) Build() []ActionSequence {
+	sequences := make([]ActionSequence, 0, 3)
+
+	for _, seq := range []*ActionSequence{b.key, b.pointer, b.wheel} {
+		if seq != nil {
+			sequences = append(sequences, *seq)
+		}
+	}
+
+	return sequences
+}
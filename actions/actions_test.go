@@ -0,0 +1,68 @@
+package actions
+
+import "testing"
+
+func TestBuilderBuildOmitsUnusedSources(t *testing.T) {
+	sequences := NewBuilder().KeyDown(KeyShift).Build()
+
+	if len(sequences) != 1 {
+		t.Fatalf("len(sequences) = %d, want 1", len(sequences))
+	}
+
+	if sequences[0].Type != KeySource {
+		t.Errorf("sequences[0].Type = %q, want %q", sequences[0].Type, KeySource)
+	}
+}
+
+func TestBuilderBuildIncludesEveryUsedSource(t *testing.T) {
+	sequences := NewBuilder().
+		KeyDown(KeyShift).
+		PointerDown(LeftButton).
+		Scroll(0, 0, 0, 100, Viewport).
+		Build()
+
+	if len(sequences) != 3 {
+		t.Fatalf("len(sequences) = %d, want 3", len(sequences))
+	}
+
+	types := map[SourceType]bool{}
+	for _, seq := range sequences {
+		types[seq.Type] = true
+	}
+
+	for _, want := range []SourceType{KeySource, PointerSource, WheelSource} {
+		if !types[want] {
+			t.Errorf("Build() sequences missing source type %q", want)
+		}
+	}
+}
+
+func TestBuilderPauseAppliesToEveryUsedSource(t *testing.T) {
+	sequences := NewBuilder().
+		KeyDown(KeyShift).
+		PointerDown(LeftButton).
+		Pause(0).
+		Build()
+
+	for _, seq := range sequences {
+		last := seq.Actions[len(seq.Actions)-1]
+		if last["type"] != "pause" {
+			t.Errorf(
+				"sequence %q last action = %v, want a pause", seq.ID, last,
+			)
+		}
+	}
+}
+
+func TestBuilderDefaultPointerType(t *testing.T) {
+	sequences := NewBuilder().PointerDown(LeftButton).Build()
+
+	params, ok := sequences[0].Parameters.(pointerParameters)
+	if !ok {
+		t.Fatalf("Parameters = %#v, want pointerParameters", sequences[0].Parameters) //nolint:lll
+	}
+
+	if params.PointerType != Mouse {
+		t.Errorf("default PointerType = %q, want %q", params.PointerType, Mouse)
+	}
+}
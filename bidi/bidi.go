@@ -0,0 +1,343 @@
+// Package bidi implements a minimal client for the WebDriver BiDi protocol.
+//
+// It opens a WebSocket connection to a driver's BiDi endpoint and lets
+// callers subscribe to BiDi events (log entries, network traffic, browsing
+// context changes, script messages) with typed Go callbacks instead of
+// polling the classic HTTP endpoints.
+package bidi
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+// Handler is called with the raw `params` payload of a matching BiDi event.
+type Handler func(params json.RawMessage)
+
+// subscriptionBacklog bounds how many unhandled events a single subscription
+// may buffer before new events are dropped to apply backpressure.
+const subscriptionBacklog = 64
+
+// commandTimeout bounds how long sendAndAwait waits for a reply before
+// giving up, so a dead connection can't hang a caller forever.
+const commandTimeout = 30 * time.Second
+
+// ErrClientClosed is returned by operations performed on a closed Client.
+var ErrClientClosed = errors.New("bidi: client is closed")
+
+type subscription struct {
+	contextID string
+	events    chan json.RawMessage
+	done      chan struct{}
+	doneOnce  sync.Once
+}
+
+// close marks the subscription done, safe to call more than once (e.g. once
+// from unsubscribe and once from Client.Close).
+func (s *subscription) close() {
+	s.doneOnce.Do(func() { close(s.done) })
+}
+
+// Client manages a single BiDi WebSocket connection and fans out incoming
+// events to registered subscriptions.
+type Client struct {
+	conn *websocket.Conn
+
+	mu            sync.Mutex
+	subscriptions map[string][]*subscription
+	pending       map[uint64]chan json.RawMessage
+	nextID        uint64
+	closed        atomic.Bool
+	closeOnce     sync.Once
+
+	// closedCh is closed once the connection is gone, either via Close or
+	// because readLoop exited on its own (e.g. the remote end hung up),
+	// unblocking any dispatchCommand call still waiting on a reply.
+	closedCh     chan struct{}
+	closedChOnce sync.Once
+}
+
+// markClosed closes closedCh at most once.
+func (c *Client) markClosed() {
+	c.closedChOnce.Do(func() { close(c.closedCh) })
+}
+
+type command struct {
+	ID     uint64      `json:"id"`
+	Method string      `json:"method"`
+	Params interface{} `json:"params"`
+}
+
+type incomingMessage struct {
+	ID     uint64          `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+}
+
+// Connect dials the given BiDi WebSocket URL (typically taken from a
+// session's `webSocketUrl` capability) and starts the event dispatch loop.
+func Connect(wsURL string) (*Client, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial BiDi endpoint")
+	}
+
+	c := &Client{
+		conn:          conn,
+		subscriptions: make(map[string][]*subscription),
+		pending:       make(map[uint64]chan json.RawMessage),
+		closedCh:      make(chan struct{}),
+	}
+
+	go c.readLoop()
+
+	return c, nil
+}
+
+// Close terminates the underlying WebSocket connection and stops dispatching
+// events to any remaining subscriptions.
+func (c *Client) Close() error {
+	var err error
+
+	c.closeOnce.Do(func() {
+		c.closed.Store(true)
+		c.markClosed()
+
+		err = c.conn.Close()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		for _, subs := range c.subscriptions {
+			for _, sub := range subs {
+				sub.close()
+			}
+		}
+
+		c.subscriptions = nil
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "failed to close BiDi connection")
+	}
+
+	return nil
+}
+
+// Subscribe registers handler to be called for every event whose method
+// matches event (e.g. "log.entryAdded"). If contextID is non-empty, events
+// from other browsing contexts are filtered out. The returned func
+// unsubscribes the handler.
+func (c *Client) Subscribe(
+	event, contextID string, handler Handler,
+) (func(), error) {
+	if c.closed.Load() {
+		return nil, ErrClientClosed
+	}
+
+	if err := c.send(command{
+		Method: "session.subscribe",
+		Params: map[string]interface{}{"events": []string{event}},
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to send subscribe command")
+	}
+
+	sub := &subscription{
+		contextID: contextID,
+		events:    make(chan json.RawMessage, subscriptionBacklog),
+		done:      make(chan struct{}),
+	}
+
+	c.mu.Lock()
+	c.subscriptions[event] = append(c.subscriptions[event], sub)
+	c.mu.Unlock()
+
+	go func() {
+		for {
+			select {
+			case params := <-sub.events:
+				handler(params)
+			case <-sub.done:
+				return
+			}
+		}
+	}()
+
+	unsubscribe := func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		subs := c.subscriptions[event]
+
+		for i, s := range subs {
+			if s == sub {
+				c.subscriptions[event] = append(subs[:i], subs[i+1:]...)
+
+				break
+			}
+		}
+
+		sub.close()
+	}
+
+	return unsubscribe, nil
+}
+
+func (c *Client) send(cmd command) error {
+	_, err := c.dispatchCommand(cmd, false)
+
+	return err
+}
+
+// sendAndAwait sends cmd and blocks until the driver replies with a result
+// for its command ID, then unmarshals {"result": <that result>} into out, so
+// out must hold the reply under a `json:"result"` field.
+func (c *Client) sendAndAwait(cmd command, out interface{}) error {
+	result, err := c.dispatchCommand(cmd, true)
+	if err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	wrapped := struct {
+		Result json.RawMessage `json:"result"`
+	}{Result: result}
+
+	data, err := json.Marshal(wrapped)
+	if err != nil {
+		return errors.Wrap(err, "failed to re-marshal BiDi result")
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return errors.Wrap(err, "failed to unmarshal BiDi result")
+	}
+
+	return nil
+}
+
+func (c *Client) dispatchCommand(
+	cmd command, awaitResult bool,
+) (json.RawMessage, error) {
+	c.mu.Lock()
+	c.nextID++
+	cmd.ID = c.nextID
+
+	var wait chan json.RawMessage
+
+	if awaitResult {
+		wait = make(chan json.RawMessage, 1)
+		c.pending[cmd.ID] = wait
+	}
+	c.mu.Unlock()
+
+	if err := c.conn.WriteJSON(cmd); err != nil {
+		return nil, errors.Wrap(err, "failed to write BiDi command")
+	}
+
+	if !awaitResult {
+		return nil, nil
+	}
+
+	select {
+	case result := <-wait:
+		return result, nil
+	case <-c.closedCh:
+		c.mu.Lock()
+		delete(c.pending, cmd.ID)
+		c.mu.Unlock()
+
+		return nil, ErrClientClosed
+	case <-time.After(commandTimeout):
+		c.mu.Lock()
+		delete(c.pending, cmd.ID)
+		c.mu.Unlock()
+
+		return nil, errors.Errorf(
+			"timed out after %s waiting for a reply to %s",
+			commandTimeout, cmd.Method,
+		)
+	}
+}
+
+func (c *Client) readLoop() {
+	defer c.markClosed()
+
+	for {
+		var msg incomingMessage
+
+		if err := c.conn.ReadJSON(&msg); err != nil {
+			return
+		}
+
+		if msg.ID != 0 && msg.Method == "" {
+			c.resolvePending(msg)
+
+			continue
+		}
+
+		if msg.Method == "" {
+			continue
+		}
+
+		c.dispatch(msg)
+	}
+}
+
+func (c *Client) resolvePending(msg incomingMessage) {
+	c.mu.Lock()
+	wait, ok := c.pending[msg.ID]
+
+	if ok {
+		delete(c.pending, msg.ID)
+	}
+	c.mu.Unlock()
+
+	if ok {
+		wait <- msg.Result
+	}
+}
+
+func (c *Client) dispatch(msg incomingMessage) {
+	c.mu.Lock()
+	subs := append([]*subscription(nil), c.subscriptions[msg.Method]...)
+	c.mu.Unlock()
+
+	for _, sub := range subs {
+		if sub.contextID != "" && !paramsMatchContext(msg.Params, sub.contextID) {
+			continue
+		}
+
+		select {
+		case sub.events <- msg.Params:
+		default:
+			// Subscriber is behind; drop the event rather than block the
+			// read loop.
+		}
+	}
+}
+
+func paramsMatchContext(params json.RawMessage, contextID string) bool {
+	// Most events carry the context at the top level; log.entryAdded and
+	// script.message carry it under "source".
+	var ctx struct {
+		Context string `json:"context"`
+		Source  struct {
+			Context string `json:"context"`
+		} `json:"source"`
+	}
+
+	if err := json.Unmarshal(params, &ctx); err != nil {
+		return true
+	}
+
+	return ctx.Context == contextID || ctx.Source.Context == contextID
+}
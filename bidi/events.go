@@ -0,0 +1,78 @@
+package bidi
+
+import "encoding/json"
+
+// LogEntry mirrors the BiDi `log.entryAdded` event payload.
+type LogEntry struct {
+	Level     string `json:"level"`
+	Text      string `json:"text"`
+	Timestamp int64  `json:"timestamp"`
+	Type      string `json:"type"`
+}
+
+// NetworkRequest mirrors the BiDi `network.beforeRequestSent` event payload.
+type NetworkRequest struct {
+	Context string `json:"context"`
+	Request struct {
+		RequestID string `json:"request"`
+		URL       string `json:"url"`
+		Method    string `json:"method"`
+	} `json:"request"`
+}
+
+// NetworkResponse mirrors the BiDi `network.responseStarted` and
+// `network.responseCompleted` event payloads.
+type NetworkResponse struct {
+	Context string `json:"context"`
+	Request struct {
+		RequestID string `json:"request"`
+	} `json:"request"`
+	Response struct {
+		URL    string `json:"url"`
+		Status int    `json:"status"`
+	} `json:"response"`
+}
+
+// BrowsingContextEvent mirrors `browsingContext.load` and similar events.
+type BrowsingContextEvent struct {
+	Context string `json:"context"`
+	URL     string `json:"url"`
+}
+
+// ScriptMessage mirrors the `script.message` event payload.
+type ScriptMessage struct {
+	Channel string          `json:"channel"`
+	Data    json.RawMessage `json:"data"`
+	Source  struct {
+		Context string `json:"context"`
+	} `json:"source"`
+}
+
+// ParseLogEntry unmarshals the raw params of a "log.entryAdded" event.
+func ParseLogEntry(params json.RawMessage) (LogEntry, error) {
+	var entry LogEntry
+
+	err := json.Unmarshal(params, &entry)
+
+	return entry, err
+}
+
+// ParseNetworkRequest unmarshals the raw params of a
+// "network.beforeRequestSent" event.
+func ParseNetworkRequest(params json.RawMessage) (NetworkRequest, error) {
+	var req NetworkRequest
+
+	err := json.Unmarshal(params, &req)
+
+	return req, err
+}
+
+// ParseNetworkResponse unmarshals the raw params of a
+// "network.responseStarted" or "network.responseCompleted" event.
+func ParseNetworkResponse(params json.RawMessage) (NetworkResponse, error) {
+	var res NetworkResponse
+
+	err := json.Unmarshal(params, &res)
+
+	return res, err
+}
@@ -0,0 +1,353 @@
+package bidi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// InterceptPhase selects which BiDi network phase an intercept applies to.
+type InterceptPhase string
+
+const (
+	BeforeRequestSent InterceptPhase = "beforeRequestSent"
+	ResponseStarted   InterceptPhase = "responseStarted"
+	AuthRequired      InterceptPhase = "authRequired"
+)
+
+// NetworkPattern selects which requests an intercept applies to.
+type NetworkPattern struct {
+	// URLGlob is matched against the request URL, e.g. "*://*.example.com/*".
+	URLGlob string
+	// Methods restricts the intercept to the given HTTP methods. A nil or
+	// empty slice matches every method.
+	Methods []string
+	// ResourceTypes restricts the intercept to the given BiDi resource
+	// types (e.g. "document", "script", "xhr"). A nil or empty slice
+	// matches every resource type.
+	ResourceTypes []string
+}
+
+// InterceptedRequest is passed to an intercept handler for every matching
+// network event.
+type InterceptedRequest struct {
+	RequestID string
+	Context   string
+	URL       string
+	Method    string
+	Phase     InterceptPhase
+}
+
+// InterceptAction is returned by an intercept handler to decide what happens
+// to the intercepted request.
+type InterceptAction struct {
+	kind    string
+	status  int
+	headers map[string]string
+	body    []byte
+	url     string
+}
+
+// Continue lets the request proceed unmodified.
+func Continue() InterceptAction {
+	return InterceptAction{kind: "continue"}
+}
+
+// Fail aborts the request with a network error.
+func Fail() InterceptAction {
+	return InterceptAction{kind: "fail"}
+}
+
+// Fulfill responds to the request directly with the given status, headers
+// and body without letting it reach the network.
+func Fulfill(status int, headers map[string]string, body []byte) InterceptAction {
+	return InterceptAction{
+		kind:    "fulfill",
+		status:  status,
+		headers: headers,
+		body:    body,
+	}
+}
+
+// Rewrite continues the request but replaces its URL before it is sent.
+func Rewrite(url string) InterceptAction {
+	return InterceptAction{kind: "rewrite", url: url}
+}
+
+// InterceptHandler decides the fate of an intercepted request.
+type InterceptHandler func(*InterceptedRequest) InterceptAction
+
+// intercept tracks the state needed to correlate BiDi network events with a
+// single registered intercept.
+type intercept struct {
+	id          string
+	pattern     NetworkPattern
+	phase       InterceptPhase
+	handler     InterceptHandler
+	unsubscribe func()
+}
+
+// AddNetworkIntercept registers handler to be invoked for every request
+// matching pattern at the given phase. It issues `network.addIntercept` and
+// subscribes to the matching BiDi network event, correlating requests by
+// their BiDi request ID so concurrent in-flight interceptions don't
+// collide. The returned func removes the intercept.
+func (c *Client) AddNetworkIntercept(
+	pattern NetworkPattern, phase InterceptPhase, handler InterceptHandler,
+) (func(), error) {
+	var urlPatterns []map[string]string
+	if pattern.URLGlob != "" {
+		urlPatterns = []map[string]string{
+			{"type": "pattern", "pattern": pattern.URLGlob},
+		}
+	}
+
+	var response struct {
+		Result struct {
+			Intercept string `json:"intercept"`
+		} `json:"result"`
+	}
+
+	if err := c.sendAndAwait(command{
+		Method: "network.addIntercept",
+		Params: map[string]interface{}{
+			"phases":      []InterceptPhase{phase},
+			"urlPatterns": urlPatterns,
+		},
+	}, &response); err != nil {
+		return nil, errors.Wrap(err, "failed to add network intercept")
+	}
+
+	ic := &intercept{
+		id:      response.Result.Intercept,
+		pattern: pattern,
+		phase:   phase,
+		handler: handler,
+	}
+
+	unsubscribe, err := c.Subscribe(
+		string("network."+phase), "", func(params json.RawMessage) {
+			c.handleInterceptEvent(ic, params)
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to intercept event")
+	}
+
+	ic.unsubscribe = unsubscribe
+
+	remove := func() {
+		ic.unsubscribe()
+
+		_ = c.send(command{ //nolint:errcheck
+			Method: "network.removeIntercept",
+			Params: map[string]interface{}{"intercept": ic.id},
+		})
+	}
+
+	return remove, nil
+}
+
+func (c *Client) handleInterceptEvent(ic *intercept, params json.RawMessage) {
+	var event struct {
+		Context      string `json:"context"`
+		ResourceType string `json:"resourceType"`
+		Request      struct {
+			RequestID string `json:"request"`
+			URL       string `json:"url"`
+			Method    string `json:"method"`
+		} `json:"request"`
+	}
+
+	if err := json.Unmarshal(params, &event); err != nil {
+		return
+	}
+
+	if !matchesPattern(
+		ic.pattern, event.Request.URL, event.Request.Method, event.ResourceType,
+	) {
+		// Not ours: let the driver continue it untouched.
+		_ = c.send(command{ //nolint:errcheck
+			Method: continueMethod(ic.phase),
+			Params: map[string]interface{}{"request": event.Request.RequestID},
+		})
+
+		return
+	}
+
+	action := ic.handler(&InterceptedRequest{
+		RequestID: event.Request.RequestID,
+		Context:   event.Context,
+		URL:       event.Request.URL,
+		Method:    event.Request.Method,
+		Phase:     ic.phase,
+	})
+
+	c.applyInterceptAction(event.Request.RequestID, ic.phase, action)
+}
+
+func (c *Client) applyInterceptAction(
+	requestID string, phase InterceptPhase, action InterceptAction,
+) {
+	params := map[string]interface{}{"request": requestID}
+
+	switch action.kind {
+	case "fail":
+		_ = c.send(command{Method: "network.failRequest", Params: params}) //nolint:errcheck,lll
+
+		return
+	case "fulfill":
+		params["statusCode"] = action.status
+		params["headers"] = headerList(action.headers)
+		params["body"] = map[string]string{
+			"type":  "base64",
+			"value": base64.StdEncoding.EncodeToString(action.body),
+		}
+
+		_ = c.send(command{Method: "network.provideResponse", Params: params}) //nolint:errcheck,lll
+
+		return
+	case "rewrite":
+		params["url"] = action.url
+	}
+
+	if phase == AuthRequired {
+		// network.continueWithAuth requires an "action" of its own,
+		// distinct from action.kind above: "default" lets the browser
+		// handle the auth prompt as it normally would. Credential
+		// injection ("provideCredentials") isn't exposed yet.
+		params["action"] = "default"
+	}
+
+	_ = c.send(command{Method: continueMethod(phase), Params: params}) //nolint:errcheck
+}
+
+func continueMethod(phase InterceptPhase) string {
+	switch phase {
+	case ResponseStarted:
+		return "network.continueResponse"
+	case AuthRequired:
+		return "network.continueWithAuth"
+	default:
+		return "network.continueRequest"
+	}
+}
+
+func headerList(headers map[string]string) []map[string]string {
+	list := make([]map[string]string, 0, len(headers))
+
+	for name, value := range headers {
+		list = append(list, map[string]string{"name": name, "value": value})
+	}
+
+	return list
+}
+
+func matchesPattern(pattern NetworkPattern, url, method, resourceType string) bool {
+	if len(pattern.Methods) > 0 && !contains(pattern.Methods, method) {
+		return false
+	}
+
+	if len(pattern.ResourceTypes) > 0 &&
+		!contains(pattern.ResourceTypes, resourceType) {
+		return false
+	}
+
+	if pattern.URLGlob != "" && !globMatch(pattern.URLGlob, url) {
+		return false
+	}
+
+	return true
+}
+
+func contains(list []string, target string) bool {
+	for _, item := range list {
+		if item == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// globMatch reports whether url matches a simple glob pattern where "*"
+// matches any sequence of characters.
+func globMatch(pattern, url string) bool {
+	parts := splitGlob(pattern)
+
+	pos := 0
+
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+
+		if i == len(parts)-1 {
+			// The final literal segment (no trailing "*") must end the URL.
+			start := len(url) - len(part)
+			if start < pos || url[start:] != part || (i == 0 && start != 0) {
+				return false
+			}
+
+			return true
+		}
+
+		idx := indexFrom(url, part, pos)
+		if idx == -1 {
+			return false
+		}
+
+		if i == 0 && idx != 0 && pattern[0] != '*' {
+			return false
+		}
+
+		pos = idx + len(part)
+	}
+
+	return true
+}
+
+func splitGlob(pattern string) []string {
+	var parts []string
+
+	start := 0
+
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] == '*' {
+			parts = append(parts, pattern[start:i])
+			start = i + 1
+		}
+	}
+
+	parts = append(parts, pattern[start:])
+
+	return parts
+}
+
+func indexFrom(s, substr string, from int) int {
+	if from > len(s) {
+		return -1
+	}
+
+	idx := indexOf(s[from:], substr)
+	if idx == -1 {
+		return -1
+	}
+
+	return from + idx
+}
+
+func indexOf(s, substr string) int {
+	if substr == "" {
+		return 0
+	}
+
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if s[i:i+len(substr)] == substr {
+			return i
+		}
+	}
+
+	return -1
+}
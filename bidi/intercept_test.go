@@ -0,0 +1,90 @@
+package bidi
+
+import "testing"
+
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		url     string
+		want    bool
+	}{
+		{"*://*.example.com/*", "https://api.example.com/users", true},
+		{"*://*.example.com/*", "https://example.org/users", false},
+		{"https://example.com/*", "https://example.com/users", true},
+		{"https://example.com/*", "http://example.com/users", false},
+		{"https://example.com/users", "https://example.com/users", true},
+		{"https://example.com/users", "https://example.com/users/1", false},
+		{"*", "anything", true},
+	}
+
+	for _, tt := range tests {
+		if got := globMatch(tt.pattern, tt.url); got != tt.want {
+			t.Errorf(
+				"globMatch(%q, %q) = %v, want %v",
+				tt.pattern, tt.url, got, tt.want,
+			)
+		}
+	}
+}
+
+func TestSplitGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{"*://*.example.com/*", []string{"", "://", ".example.com/", ""}},
+		{"https://example.com/users", []string{"https://example.com/users"}},
+		{"*", []string{"", ""}},
+	}
+
+	for _, tt := range tests {
+		got := splitGlob(tt.pattern)
+
+		if len(got) != len(tt.want) {
+			t.Fatalf("splitGlob(%q) = %v, want %v", tt.pattern, got, tt.want)
+		}
+
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("splitGlob(%q) = %v, want %v", tt.pattern, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestMatchesPatternResourceType(t *testing.T) {
+	pattern := NetworkPattern{ResourceTypes: []string{"image"}}
+
+	if matchesPattern(pattern, "https://example.com/app.js", "GET", "script") {
+		t.Error("matchesPattern matched a script request against an image-only pattern") //nolint:lll
+	}
+
+	if !matchesPattern(pattern, "https://example.com/logo.png", "GET", "image") {
+		t.Error("matchesPattern rejected an image request matching an image-only pattern") //nolint:lll
+	}
+
+	unfiltered := NetworkPattern{}
+	if !matchesPattern(unfiltered, "https://example.com", "GET", "xhr") {
+		t.Error("matchesPattern rejected a request against a pattern with no ResourceTypes filter") //nolint:lll
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	tests := []struct {
+		s, substr string
+		want      int
+	}{
+		{"hello world", "world", 6},
+		{"hello world", "bye", -1},
+		{"hello world", "", 0},
+		{"", "x", -1},
+	}
+
+	for _, tt := range tests {
+		if got := indexOf(tt.s, tt.substr); got != tt.want {
+			t.Errorf(
+				"indexOf(%q, %q) = %d, want %d", tt.s, tt.substr, got, tt.want,
+			)
+		}
+	}
+}
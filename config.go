@@ -2,6 +2,7 @@ package selenium
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"time"
 
@@ -40,8 +41,10 @@ type config struct {
 	Runner                   *RunnerSettings  `json:"runner"`
 	RaiseErrorsAutomatically bool             `json:"raise_errors_automatically"` //nolint:lll
 	ElementSettings          *ElementSettings `json:"element_settings,omitempty"` //nolint:lll
-	// TODO: Allow running multiple drivers.
-	WebDriver *WebDriverConfig `json:"webdriver,omitempty"`
+	// WebDrivers is keyed by a user-chosen alias (e.g. "chrome-stable",
+	// "firefox-nightly") so a single config can describe an entire browser
+	// matrix for the Runner to fan tests out across.
+	WebDrivers map[string]*WebDriverConfig `json:"webdrivers,omitempty"`
 }
 
 var Config *config
@@ -195,23 +198,40 @@ func (c *config) validateElement() {
 }
 
 func (c *config) validateWebDriver() {
-	if c.WebDriver.PathToBinary == "" {
+	if len(c.WebDrivers) == 0 {
 		logger.Warn(
-			`"webdriver.binary" is not set. Defaulting to "chromedriver".`,
+			`"webdrivers" is not set. Defaulting to a single "default" entry.`,
 		)
 
-		c.WebDriver.PathToBinary = "chromedriver"
+		c.WebDrivers = map[string]*WebDriverConfig{"default": {}}
 	}
 
-	if c.WebDriver.Timeout.Duration <= 0 {
-		logger.Warn(`"timeout" is not set. Defaulting to "10s".`)
+	for alias, wd := range c.WebDrivers {
+		if wd.PathToBinary == "" {
+			logger.Warn(fmt.Sprintf(
+				`"webdrivers.%s.path" is not set. Defaulting to "chromedriver".`,
+				alias,
+			))
 
-		c.WebDriver.Timeout = types.Time{Duration: 10 * time.Second}
-	}
+			wd.PathToBinary = "chromedriver"
+		}
+
+		if wd.Timeout.Duration <= 0 {
+			logger.Warn(fmt.Sprintf(
+				`"webdrivers.%s.timeout" is not set. Defaulting to "10s".`, alias,
+			))
 
-	if c.WebDriver.URL == "" {
-		logger.Warn(`"url" is not set. Defaulting to "http://localhost:4444".`)
+			wd.Timeout = types.Time{Duration: 10 * time.Second}
+		}
+
+		if wd.URL == "" {
+			logger.Warn(fmt.Sprintf(
+				`"webdrivers.%s.url" is not set. Defaulting to `+
+					`"http://localhost:4444".`,
+				alias,
+			))
 
-		c.WebDriver.URL = "http://localhost:4444"
+			wd.URL = "http://localhost:4444"
+		}
 	}
 }
\ No newline at end of file
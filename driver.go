@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -24,7 +25,12 @@ type Driver struct {
 	port          int
 	remoteURL     string
 	timeout       types.Time
+	capabilities  map[string]interface{}
 	cmd           *exec.Cmd
+	probe         ReadinessProbe
+
+	recordersMu sync.Mutex
+	recorders   map[*Session]*videoRecorder
 }
 
 func NewDriver(
@@ -51,11 +57,25 @@ func NewDriver(
 		webDriverPath: webdriverPath,
 		port:          port,
 		remoteURL:     remoteURL,
+		probe:         probeForBinary(webdriverPath),
 	}, nil
 }
 
+// UseReadinessProbe overrides the probe that decides when the driver is
+// ready to accept commands. This is mainly useful for grid endpoints that
+// expose neither a recognizable startup banner nor a local process to
+// watch.
+func (d *Driver) UseReadinessProbe(probe ReadinessProbe) {
+	d.probe = probe
+}
+
 func (d *Driver) Start(conf *config.WebDriverConfig) error {
 	d.timeout = conf.Timeout
+	d.capabilities = conf.Capabalities
+
+	if d.webDriverPath == "" {
+		return d.probe.WaitReady(d, nil)
+	}
 
 	//nolint:gosec
 	cmd := exec.Command(d.webDriverPath, fmt.Sprintf("--port=%d", d.port))
@@ -73,21 +93,33 @@ func (d *Driver) Start(conf *config.WebDriverConfig) error {
 		return errors.Wrap(err, "failed to start command")
 	}
 
-	ready := make(chan bool, 1)
+	lines := make(chan string)
 
-	go printLogs(ready, d, output)
+	go scanLogs(d, output, lines)
 
-	select {
-	case <-ready:
-		return nil
-	case <-time.After(d.timeout.Duration):
-		return errors.Errorf(
-			"failed to start driver within %s", d.timeout.String(),
-		)
+	if _, ok := d.probe.(BannerReadinessProbe); !ok {
+		// Only BannerReadinessProbe actually reads lines. Every other
+		// probe (HTTPReadinessProbe, the default for unrecognized or
+		// safaridriver binaries) ignores it entirely, so without a
+		// reader scanLogs blocks forever on its first line, leaking the
+		// goroutine and eventually stalling the driver subprocess once
+		// its stdout pipe buffer fills.
+		go drainLines(lines)
+	}
+
+	return d.probe.WaitReady(d, lines)
+}
+
+// drainLines discards every line sent to lines, keeping scanLogs unblocked
+// for probes that don't watch process output themselves.
+func drainLines(lines <-chan string) {
+	for range lines { //nolint:revive
 	}
 }
 
 func (d *Driver) Stop() error {
+	d.killOwnRecordings()
+
 	if d.cmd == nil {
 		return nil
 	}
@@ -118,7 +150,12 @@ func (d *Driver) IsReady(c *client) (bool, error) {
 	return response.Value.Ready, nil
 }
 
-func printLogs(ready chan<- bool, d *Driver, output io.ReadCloser) {
+// scanLogs prints every line the driver process writes to stdout/stderr,
+// forwarding each one to lines for a ReadinessProbe to inspect. lines is
+// closed once the process's output ends.
+func scanLogs(d *Driver, output io.ReadCloser, lines chan<- string) {
+	defer close(lines)
+
 	scanner := bufio.NewScanner(output)
 
 	for scanner.Scan() {
@@ -136,9 +173,6 @@ func printLogs(ready chan<- bool, d *Driver, output io.ReadCloser) {
 			d.Stop() //nolint:errcheck
 		}
 
-		// TODO: Add handling for FF
-		if strings.Contains(line, "ChromeDriver was started successfully") {
-			ready <- true
-		}
+		lines <- line
 	}
 }
@@ -0,0 +1,193 @@
+package selenium
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Element represents a single web element found within a Session's current
+// browsing context, identified lazily by locator/selector the first time an
+// operation needs its W3C element reference.
+type Element struct {
+	id       string
+	session  *Session
+	locator  string
+	selector string
+}
+
+//nolint:tagliatelle
+type elementIDResponse struct {
+	Value struct {
+		ElementID string `json:"element-6066-11e4-a52e-4f735466cecf"`
+	} `json:"value"`
+}
+
+// FindElement returns an Element matching selector under s's default locator
+// strategy (UseCSS/UseXPath), resolving its element reference immediately.
+func (s *Session) FindElement(sel string) *Element {
+	e := &Element{session: s, locator: s.defaultLocator, selector: sel}
+
+	e.setElementID()
+
+	return e
+}
+
+// FindElements returns every Element currently matching selector under s's
+// default locator strategy. Unlike FindElement, it never retries: zero
+// results is a valid outcome, not a not-found error.
+func (s *Session) FindElements(sel string) []*Element {
+	var response struct {
+		Value []struct {
+			ElementID string `json:"element-6066-11e4-a52e-4f735466cecf"` //nolint:lll,tagliatelle
+		} `json:"value"`
+	}
+
+	payload := struct {
+		Using string `json:"using"`
+		Value string `json:"value"`
+	}{s.defaultLocator, sel}
+
+	res, err := s.api.executeRequestCustom(
+		http.MethodPost, fmt.Sprintf("/session/%s/elements", s.id),
+		payload, &response,
+	)
+	if err != nil {
+		handleError(res, errors.Wrap(err, "failed to find elements"))
+
+		return nil
+	}
+
+	elements := make([]*Element, 0, len(response.Value))
+
+	for _, v := range response.Value {
+		elements = append(elements, &Element{
+			id:       v.ElementID,
+			session:  s,
+			locator:  s.defaultLocator,
+			selector: sel,
+		})
+	}
+
+	return elements
+}
+
+// setElementID resolves e's underlying W3C element reference, polling at
+// Config.ElementSettings.PollInterval until it's found or RetryTimeout
+// elapses. It is a no-op once e.id is already known.
+//
+// This loop is intentionally a separate, package-local implementation
+// rather than a user of wait.Until: the wait package imports this package
+// to type wait.Condition against *Session, so this package can't import
+// wait back without an import cycle.
+func (e *Element) setElementID() {
+	if e.id != "" {
+		return
+	}
+
+	settings := Config.ElementSettings
+	deadline := time.Now().Add(settings.RetryTimeout.Duration)
+
+	payload := struct {
+		Using string `json:"using"`
+		Value string `json:"value"`
+	}{e.locator, e.selector}
+
+	for {
+		var response elementIDResponse
+
+		res, err := e.session.api.executeRequestCustom(
+			http.MethodPost,
+			fmt.Sprintf("/session/%s/element", e.session.id),
+			payload, &response,
+		)
+		if err == nil {
+			e.id = response.Value.ElementID
+
+			return
+		}
+
+		if time.Now().After(deadline) {
+			if !settings.IgnoreNotFound {
+				handleError(res, errors.Wrapf(
+					err, "failed to find element %q", e.selector,
+				))
+			}
+
+			return
+		}
+
+		time.Sleep(settings.PollInterval.Duration)
+	}
+}
+
+// IsDisplayed reports whether e is currently displayed.
+func (e *Element) IsDisplayed() bool {
+	e.setElementID()
+
+	var response struct {
+		Value bool `json:"value"`
+	}
+
+	res, err := e.session.api.executeRequestCustom(
+		http.MethodGet,
+		fmt.Sprintf("/session/%s/element/%s/displayed", e.session.id, e.id),
+		struct{}{}, &response,
+	)
+	if err != nil {
+		handleError(res, errors.Wrap(err, "failed to check displayed state"))
+
+		return false
+	}
+
+	return response.Value
+}
+
+// IsStale reports whether e is no longer attached to the DOM. Only the W3C
+// "stale element reference" error counts as staleness; any other error (a
+// transient network blip, a 500 from the driver) is not, and is swallowed as
+// false so it isn't mistaken for staleness.
+func (e *Element) IsStale() bool {
+	var response struct {
+		Value bool `json:"value"`
+	}
+
+	_, err := e.session.api.executeRequestCustom(
+		http.MethodGet,
+		fmt.Sprintf("/session/%s/element/%s/enabled", e.session.id, e.id),
+		struct{}{}, &response,
+	)
+
+	return err != nil && strings.Contains(err.Error(), "stale element reference")
+}
+
+// GetText returns e's visible text.
+func (e *Element) GetText() string {
+	e.setElementID()
+
+	res, err := e.session.api.executeRequestVoid(
+		http.MethodGet,
+		fmt.Sprintf("/session/%s/element/%s/text", e.session.id, e.id),
+	)
+	if err != nil {
+		handleError(res, errors.Wrap(err, "failed to get element text"))
+
+		return ""
+	}
+
+	text, _ := res.Value.(string)
+
+	return text
+}
+
+// IsAlertPresent reports whether a JavaScript alert is currently open.
+func (s *Session) IsAlertPresent() bool {
+	res, err := s.api.executeRequestVoid(
+		http.MethodGet, fmt.Sprintf("/session/%s/alert/text", s.id),
+	)
+
+	return err == nil && res.Value != nil
+}
@@ -0,0 +1,127 @@
+package selenium
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ReadinessProbe decides when a starting browser driver is ready to accept
+// commands. Register a custom probe with Driver.UseReadinessProbe for grid
+// endpoints where no local process exists to watch.
+type ReadinessProbe interface {
+	// WaitReady blocks until d is ready, or returns an error once d's
+	// configured timeout elapses. Probes that watch process output read
+	// from lines; lines is closed once the driver process's output ends.
+	// Probes that don't rely on process output (e.g. HTTPReadinessProbe)
+	// may ignore lines, which is nil when the driver has no local process.
+	WaitReady(d *Driver, lines <-chan string) error
+}
+
+// BannerReadinessProbe waits for Banner to appear as a substring of a line
+// written to the driver process's stdout/stderr.
+type BannerReadinessProbe struct {
+	Banner string
+}
+
+func (p BannerReadinessProbe) WaitReady(d *Driver, lines <-chan string) error {
+	deadline := time.After(d.timeout.Duration)
+
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				return errors.New(
+					"driver process exited before reporting readiness",
+				)
+			}
+
+			if strings.Contains(line, p.Banner) {
+				return nil
+			}
+		case <-deadline:
+			return errors.Errorf(
+				"failed to start driver within %s", d.timeout.String(),
+			)
+		}
+	}
+}
+
+// HTTPReadinessProbe ignores process output entirely and polls the driver's
+// `GET /status` endpoint at Interval (defaulting to 100ms) until it reports
+// `value.ready == true`. It is the fallback probe for drivers with no
+// recognizable startup banner, and the only option for drivers with no
+// local process (e.g. a remote grid).
+type HTTPReadinessProbe struct {
+	Interval time.Duration
+}
+
+func (p HTTPReadinessProbe) WaitReady(d *Driver, _ <-chan string) error {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = 100 * time.Millisecond
+	}
+
+	deadline := time.After(d.timeout.Duration)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if ready, err := pollDriverStatus(d.remoteURL); err == nil && ready {
+				return nil
+			}
+		case <-deadline:
+			return errors.Errorf(
+				"failed to start driver within %s", d.timeout.String(),
+			)
+		}
+	}
+}
+
+func pollDriverStatus(remoteURL string) (bool, error) {
+	res, err := http.Get(remoteURL + "/status") //nolint:noctx,gosec
+	if err != nil {
+		return false, errors.Wrap(err, "failed to reach /status")
+	}
+	defer res.Body.Close()
+
+	var body struct {
+		Value struct {
+			Ready bool `json:"ready"`
+		} `json:"value"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return false, errors.Wrap(err, "failed to decode /status response")
+	}
+
+	return body.Value.Ready, nil
+}
+
+// probeForBinary picks a built-in ReadinessProbe based on the driver
+// binary's basename, falling back to HTTPReadinessProbe for unrecognized or
+// empty paths (e.g. a remote grid endpoint).
+func probeForBinary(webDriverPath string) ReadinessProbe {
+	name := filepath.Base(webDriverPath)
+
+	switch {
+	case strings.Contains(name, "chromedriver"):
+		return BannerReadinessProbe{Banner: "ChromeDriver was started successfully"} //nolint:lll
+	case strings.Contains(name, "geckodriver"):
+		return BannerReadinessProbe{Banner: "Listening on"}
+	case strings.Contains(name, "msedgedriver"):
+		return BannerReadinessProbe{Banner: "Microsoft Edge was started successfully"} //nolint:lll
+	case strings.Contains(name, "safaridriver"):
+		// safaridriver has no recognizable startup banner, so poll /status
+		// instead of watching process output.
+		return HTTPReadinessProbe{}
+	default:
+		return HTTPReadinessProbe{}
+	}
+}
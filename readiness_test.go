@@ -0,0 +1,42 @@
+package selenium
+
+import "testing"
+
+func TestProbeForBinary(t *testing.T) {
+	tests := []struct {
+		path       string
+		wantBanner string
+	}{
+		{"/usr/local/bin/chromedriver", "ChromeDriver was started successfully"},
+		{"/usr/local/bin/geckodriver", "Listening on"},
+		{"/usr/local/bin/msedgedriver", "Microsoft Edge was started successfully"}, //nolint:lll
+	}
+
+	for _, tt := range tests {
+		probe, ok := probeForBinary(tt.path).(BannerReadinessProbe)
+		if !ok {
+			t.Errorf("probeForBinary(%q) is not a BannerReadinessProbe", tt.path)
+
+			continue
+		}
+
+		if probe.Banner != tt.wantBanner {
+			t.Errorf(
+				"probeForBinary(%q).Banner = %q, want %q",
+				tt.path, probe.Banner, tt.wantBanner,
+			)
+		}
+	}
+
+	httpFallbacks := []string{
+		"/usr/local/bin/safaridriver",
+		"",
+		"/usr/local/bin/unknowndriver",
+	}
+
+	for _, path := range httpFallbacks {
+		if _, ok := probeForBinary(path).(HTTPReadinessProbe); !ok {
+			t.Errorf("probeForBinary(%q) is not an HTTPReadinessProbe", path)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package selenium
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/theRealAlpaca/go-selenium/logger"
+)
+
+// Runner manages one Driver per browser in the configured WebDrivers matrix
+// and fans test funcs out across them, turning the module from a
+// single-browser client into a parallel, multi-browser test harness.
+type Runner struct {
+	drivers map[string]*Driver
+
+	mu       sync.Mutex
+	shutdown chan struct{}
+	once     sync.Once
+}
+
+// NewRunner starts a Driver for every alias in Config.WebDrivers and
+// registers a SIGINT/SIGTERM handler that stops all of them.
+func NewRunner() (*Runner, error) {
+	r := &Runner{
+		drivers:  make(map[string]*Driver),
+		shutdown: make(chan struct{}),
+	}
+
+	for alias, wd := range Config.WebDrivers {
+		driver, err := NewDriver(wd.PathToBinary, wd.URL)
+		if err != nil {
+			r.StopAll() //nolint:errcheck
+
+			return nil, errors.Wrapf(err, "failed to create driver %q", alias)
+		}
+
+		// Capabilities/timeout must be set here rather than left for
+		// Start to assign: ManualStart drivers never call Start, and
+		// NewSession reads driver.capabilities directly, so a
+		// manually-started driver would otherwise get a session with no
+		// capabilities at all.
+		driver.capabilities = wd.Capabalities
+		driver.timeout = wd.Timeout
+
+		// Register the driver before attempting Start so that a failed
+		// Start (e.g. the readiness probe times out after the subprocess
+		// is already running) still gets cleaned up by StopAll below,
+		// instead of leaking the process.
+		r.drivers[alias] = driver
+
+		if !wd.ManualStart {
+			if err := driver.Start(wd); err != nil {
+				r.StopAll() //nolint:errcheck
+
+				return nil, errors.Wrapf(err, "failed to start driver %q", alias)
+			}
+		}
+	}
+
+	r.handleSignals()
+
+	return r, nil
+}
+
+// handleSignals stops every managed Driver when the process receives
+// SIGINT or SIGTERM.
+func (r *Runner) handleSignals() {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case <-sigCh:
+			if err := r.StopAll(); err != nil {
+				logger.Warn(err.Error())
+			}
+		case <-r.shutdown:
+		}
+	}()
+}
+
+// StopAll stops every Driver managed by the Runner. It is safe to call more
+// than once.
+func (r *Runner) StopAll() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.once.Do(func() { close(r.shutdown) })
+
+	var firstErr error
+
+	for alias, driver := range r.drivers {
+		if err := driver.Stop(); err != nil && firstErr == nil {
+			firstErr = errors.Wrapf(err, "failed to stop driver %q", alias)
+		}
+	}
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return nil
+}
+
+// Run opens a Session against every driver in the matrix and calls fn with
+// each one as a subtest, load-balancing the matrix across
+// Config.Runner.ParallelRuns workers.
+func (r *Runner) Run(t *testing.T, fn func(s *Session)) {
+	t.Helper()
+
+	aliases := make([]string, 0, len(r.drivers))
+	for alias := range r.drivers {
+		aliases = append(aliases, alias)
+	}
+
+	parallelRuns := 1
+	if Config.Runner != nil && Config.Runner.ParallelRuns > 0 {
+		parallelRuns = Config.Runner.ParallelRuns
+	}
+
+	jobs := make(chan string)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < parallelRuns; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for alias := range jobs {
+				r.runOne(t, alias, fn)
+			}
+		}()
+	}
+
+	for _, alias := range aliases {
+		jobs <- alias
+	}
+
+	close(jobs)
+
+	wg.Wait()
+}
+
+func (r *Runner) runOne(t *testing.T, alias string, fn func(s *Session)) {
+	t.Helper()
+
+	t.Run(alias, func(t *testing.T) {
+		session, err := NewSession(r.drivers[alias])
+		if err != nil {
+			t.Fatalf("failed to create session for %q: %s", alias, err)
+
+			return
+		}
+
+		defer session.Close() //nolint:errcheck
+
+		fn(session)
+	})
+}
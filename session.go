@@ -1,9 +1,13 @@
 package selenium
 
 import (
+	"fmt"
+	"net/http"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/theRealAlpaca/go-selenium/api"
+	"github.com/theRealAlpaca/go-selenium/bidi"
 	"github.com/theRealAlpaca/go-selenium/selector"
 	"github.com/theRealAlpaca/go-selenium/types"
 )
@@ -13,12 +17,72 @@ type Session struct {
 	defaultLocator string
 	// TODO: Maybe create a custom struct for handling error types. Maybe just
 	// an alias to string? Maybe could implement Error interface?
-	errors []string
-	api    *api.APIClient
+	errors   []string
+	api      *api.APIClient
+	bidi     *bidi.Client
+	recorder *videoRecorder
+	// driver is the Driver that created this session, used to scope
+	// driver-owned state (e.g. in-progress video recordings) to the
+	// driver that owns it.
+	driver *Driver
 }
 
 var _ types.Sessioner = (*Session)(nil)
 
+// NewSession starts a new WebDriver session against d and returns a
+// ready-to-use Session.
+func NewSession(d *Driver) (*Session, error) {
+	apiClient := api.NewAPIClient(d.remoteURL)
+
+	var response struct {
+		Value struct {
+			SessionID string `json:"sessionId"`
+		} `json:"value"`
+	}
+
+	payload := struct {
+		Capabilities struct {
+			AlwaysMatch map[string]interface{} `json:"alwaysMatch"`
+		} `json:"capabilities"`
+	}{}
+	payload.Capabilities.AlwaysMatch = d.capabilities
+
+	err := apiClient.ExecuteRequestCustom(
+		http.MethodPost, "/session", payload, &response,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create session")
+	}
+
+	return &Session{
+		id:             response.Value.SessionID,
+		defaultLocator: selector.CSS,
+		api:            apiClient,
+		driver:         d,
+	}, nil
+}
+
+// Close ends the session, deleting it on the remote driver and stopping any
+// in-progress video recording.
+func (s *Session) Close() error {
+	if s.recorder != nil {
+		if _, err := s.StopRecording(); err != nil {
+			return errors.Wrap(err, "failed to stop recording")
+		}
+	}
+
+	res, err := s.api.executeRequestVoid(
+		http.MethodDelete, fmt.Sprintf("/session/%s", s.id),
+	)
+	if err != nil {
+		handleError(res, err)
+
+		return errors.Wrap(err, "failed to close session")
+	}
+
+	return nil
+}
+
 func (s *Session) GetID() string {
 	return s.id
 }
@@ -0,0 +1,61 @@
+package selenium
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/theRealAlpaca/go-selenium/actions"
+)
+
+//nolint:tagliatelle
+type elementOrigin struct {
+	ElementID string `json:"element-6066-11e4-a52e-4f735466cecf"`
+}
+
+// elementRef builds the W3C element reference PointerMove, Scroll,
+// DragAndDrop and DoubleClick accept as an origin.
+func elementRef(e *Element) elementOrigin {
+	e.setElementID()
+
+	return elementOrigin{e.id}
+}
+
+// PerformActions compiles and dispatches the given action sequences,
+// typically built with actions.NewBuilder().Build().
+func (s *Session) PerformActions(sequences ...actions.ActionSequence) *Session {
+	payload := struct {
+		Actions []actions.ActionSequence `json:"actions"`
+	}{sequences}
+
+	res, err := s.api.executeRequest(
+		http.MethodPost, fmt.Sprintf("/session/%s/actions", s.id), payload,
+	)
+	if err != nil {
+		handleError(res, err)
+	}
+
+	return s
+}
+
+// ReleaseActions releases all input sources' state (keys held down, pointer
+// buttons pressed, etc.) accumulated by previous PerformActions calls.
+func (s *Session) ReleaseActions() *Session {
+	res, err := s.api.executeRequestVoid(
+		http.MethodDelete, fmt.Sprintf("/session/%s/actions", s.id),
+	)
+	if err != nil {
+		handleError(res, err)
+	}
+
+	return s
+}
+
+// DragAndDrop, DoubleClick and TypeWithModifiers live on actions.Builder so
+// they can be composed with other actions before a single PerformActions
+// dispatch, e.g.:
+//
+//	sequences := actions.NewBuilder().
+//		DragAndDrop(elementRef(from), elementRef(to)).
+//		Scroll(0, 0, 0, 100, actions.Viewport).
+//		Build()
+//	session.PerformActions(sequences...)
@@ -0,0 +1,82 @@
+package selenium
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/theRealAlpaca/go-selenium/bidi"
+)
+
+// ErrBiDiNotEnabled is returned when a BiDi-dependent method is called before
+// EnableBiDi.
+var ErrBiDiNotEnabled = errors.New("bidi: session does not have BiDi enabled")
+
+// EnableBiDi opens the WebDriver BiDi WebSocket connection advertised by the
+// session's `webSocketUrl` capability. It must be called once, right after
+// the session is created, before Subscribe, SubscribeNetwork or
+// OnConsoleLog are used.
+func (s *Session) EnableBiDi(webSocketURL string) error {
+	c, err := bidi.Connect(webSocketURL)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to BiDi endpoint")
+	}
+
+	s.bidi = c
+
+	return nil
+}
+
+// Subscribe registers handler to be called for every BiDi event matching
+// event (e.g. "log.entryAdded"). If contextID is non-empty, only events for
+// that browsing context are delivered. The returned func unsubscribes the
+// handler.
+func (s *Session) Subscribe(
+	event, contextID string, handler bidi.Handler,
+) (func(), error) {
+	if s.bidi == nil {
+		return nil, ErrBiDiNotEnabled
+	}
+
+	unsubscribe, err := s.bidi.Subscribe(event, contextID, handler)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to subscribe to %q", event)
+	}
+
+	return unsubscribe, nil
+}
+
+// SubscribeNetwork registers handler to be called for every
+// "network.beforeRequestSent" event, parsed into a bidi.NetworkRequest. If
+// contextID is non-empty, only events for that browsing context are
+// delivered.
+func (s *Session) SubscribeNetwork(
+	contextID string, handler func(bidi.NetworkRequest),
+) (func(), error) {
+	return s.Subscribe(
+		"network.beforeRequestSent", contextID,
+		func(params json.RawMessage) {
+			req, err := bidi.ParseNetworkRequest(params)
+			if err != nil {
+				return
+			}
+
+			handler(req)
+		},
+	)
+}
+
+// OnConsoleLog registers handler to be called for every "log.entryAdded"
+// event, parsed into a bidi.LogEntry. It is a convenience wrapper around
+// Subscribe for the most common BiDi use case.
+func (s *Session) OnConsoleLog(handler func(bidi.LogEntry)) (func(), error) {
+	return s.Subscribe(
+		"log.entryAdded", "", func(params json.RawMessage) {
+			entry, err := bidi.ParseLogEntry(params)
+			if err != nil {
+				return
+			}
+
+			handler(entry)
+		},
+	)
+}
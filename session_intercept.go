@@ -0,0 +1,20 @@
+package selenium
+
+import "github.com/theRealAlpaca/go-selenium/bidi"
+
+// AddNetworkIntercept registers handler to decide the fate of every request
+// matching pattern at the given phase: Continue, Fail, Fulfill a canned
+// response, or Rewrite the URL before it leaves the browser. It requires
+// EnableBiDi to have been called first. The returned func removes the
+// intercept.
+func (s *Session) AddNetworkIntercept(
+	pattern bidi.NetworkPattern,
+	phase bidi.InterceptPhase,
+	handler bidi.InterceptHandler,
+) (func(), error) {
+	if s.bidi == nil {
+		return nil, ErrBiDiNotEnabled
+	}
+
+	return s.bidi.AddNetworkIntercept(pattern, phase, handler) //nolint:wrapcheck,lll
+}
@@ -0,0 +1,238 @@
+package selenium
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SessionOptions configures per-session behavior that isn't covered by
+// ElementSettings, such as on-failure video capture.
+type SessionOptions struct {
+	RecordVideo bool
+	VideoDir    string
+	// Codec is passed to ffmpeg as -c:v. Defaults to "libx264".
+	Codec string
+	// FPS is the screenshot capture rate. Defaults to 5.
+	FPS int
+}
+
+// videoRecorder pipes a screenshot loop into an ffmpeg process for a single
+// session.
+type videoRecorder struct {
+	cmd   *exec.Cmd
+	stdin interface {
+		Write([]byte) (int, error)
+		Close() error
+	}
+	stop     chan struct{}
+	done     chan struct{}
+	path     string
+	stopOnce sync.Once
+}
+
+// requestStop closes r.stop, safe to call more than once (e.g. once from
+// StopRecording and once from Driver.killOwnRecordings racing it).
+func (r *videoRecorder) requestStop() {
+	r.stopOnce.Do(func() { close(r.stop) })
+}
+
+// StartRecording begins capturing the session's screen to VideoDir at FPS,
+// by looping screenshots into `ffmpeg -f image2pipe ... out.mp4`. Recording
+// stops on StopRecording or when the owning Driver is stopped, whichever
+// comes first.
+func (s *Session) StartRecording(opts SessionOptions) error {
+	if !opts.RecordVideo {
+		return nil
+	}
+
+	if s.recorder != nil {
+		return errors.New("recording already in progress for this session")
+	}
+
+	fps := opts.FPS
+	if fps <= 0 {
+		fps = 5
+	}
+
+	codec := opts.Codec
+	if codec == "" {
+		codec = "libx264"
+	}
+
+	if err := os.MkdirAll(opts.VideoDir, 0o755); err != nil {
+		return errors.Wrap(err, "failed to create video directory")
+	}
+
+	outPath := filepath.Join(opts.VideoDir, s.id+".mp4")
+
+	//nolint:gosec
+	cmd := exec.Command(
+		"ffmpeg",
+		"-y",
+		"-f", "image2pipe",
+		"-framerate", strconv.Itoa(fps),
+		"-i", "-",
+		"-c:v", codec,
+		outPath,
+	)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return errors.Wrap(err, "failed to open ffmpeg stdin")
+	}
+
+	if err := cmd.Start(); err != nil {
+		return errors.Wrap(err, "failed to start ffmpeg")
+	}
+
+	r := &videoRecorder{
+		cmd:   cmd,
+		stdin: stdin,
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+		path:  outPath,
+	}
+
+	s.recorder = r
+
+	if s.driver != nil {
+		s.driver.registerRecorder(s, r)
+	}
+
+	go s.recordLoop(r, fps)
+
+	return nil
+}
+
+func (s *Session) recordLoop(r *videoRecorder, fps int) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(time.Second / time.Duration(fps))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			frame, err := s.captureFrame()
+			if err != nil {
+				continue
+			}
+
+			if _, err := r.stdin.Write(frame); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *Session) captureFrame() ([]byte, error) {
+	res, err := s.api.executeRequestVoid(
+		http.MethodGet, fmt.Sprintf("/session/%s/screenshot", s.id),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to take screenshot")
+	}
+
+	v, ok := res.Value.(string)
+	if !ok {
+		return nil, errors.New("unexpected screenshot response")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode screenshot")
+	}
+
+	return data, nil
+}
+
+// StopRecording stops the screenshot loop, closes ffmpeg's stdin, waits for
+// it to finish encoding and returns the resulting file path for CI artifact
+// upload.
+func (s *Session) StopRecording() (string, error) {
+	if s.recorder == nil {
+		return "", nil
+	}
+
+	r := s.recorder
+
+	r.requestStop()
+	<-r.done
+
+	if err := r.stdin.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to close ffmpeg stdin")
+	}
+
+	err := r.cmd.Wait()
+
+	s.recorder = nil
+
+	if s.driver != nil {
+		s.driver.unregisterRecorder(s)
+	}
+
+	if err != nil {
+		return "", errors.Wrap(err, "ffmpeg exited with an error")
+	}
+
+	return r.path, nil
+}
+
+// registerRecorder tracks r as belonging to d, so d.killOwnRecordings only
+// ever stops recordings started on sessions it created.
+func (d *Driver) registerRecorder(s *Session, r *videoRecorder) {
+	d.recordersMu.Lock()
+	defer d.recordersMu.Unlock()
+
+	if d.recorders == nil {
+		d.recorders = make(map[*Session]*videoRecorder)
+	}
+
+	d.recorders[s] = r
+}
+
+func (d *Driver) unregisterRecorder(s *Session) {
+	d.recordersMu.Lock()
+	defer d.recordersMu.Unlock()
+
+	delete(d.recorders, s)
+}
+
+// killOwnRecordings force-stops every still-running video recording started
+// on a session this Driver created. It is called from Driver.Stop so
+// stopping one driver in a multi-driver Runner never leaves an ffmpeg
+// process behind, without affecting recordings owned by other drivers.
+func (d *Driver) killOwnRecordings() {
+	d.recordersMu.Lock()
+	owned := make(map[*Session]*videoRecorder, len(d.recorders))
+
+	for s, r := range d.recorders {
+		owned[s] = r
+	}
+
+	d.recorders = nil
+	d.recordersMu.Unlock()
+
+	for s, r := range owned {
+		r.requestStop()
+		<-r.done
+		r.stdin.Close() //nolint:errcheck
+
+		if r.cmd.Process != nil {
+			r.cmd.Process.Kill() //nolint:errcheck
+		}
+
+		s.recorder = nil
+	}
+}
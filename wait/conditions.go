@@ -0,0 +1,74 @@
+package wait
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/theRealAlpaca/go-selenium"
+)
+
+// URLIs reports whether the current browsing context's URL equals url.
+func URLIs(url string) Condition {
+	return func(s *selenium.Session) (bool, error) {
+		return s.GetCurrentURL() == url, nil
+	}
+}
+
+// URLMatches reports whether the current browsing context's URL matches re.
+func URLMatches(re *regexp.Regexp) Condition {
+	return func(s *selenium.Session) (bool, error) {
+		return re.MatchString(s.GetCurrentURL()), nil
+	}
+}
+
+// TitleContains reports whether the current page title contains substr.
+func TitleContains(substr string) Condition {
+	return func(s *selenium.Session) (bool, error) {
+		return strings.Contains(s.GetTitle(), substr), nil
+	}
+}
+
+// NumberOfWindowsToBe reports whether the session currently has exactly n
+// open window handles.
+func NumberOfWindowsToBe(n int) Condition {
+	return func(s *selenium.Session) (bool, error) {
+		return len(s.GetWindowHandles()) == n, nil
+	}
+}
+
+// ElementVisible reports whether e is present and displayed.
+func ElementVisible(e *selenium.Element) Condition {
+	return func(*selenium.Session) (bool, error) {
+		return e.IsDisplayed(), nil
+	}
+}
+
+// ElementCount reports whether exactly n elements currently match selector
+// within s's default locator strategy.
+func ElementCount(selector string, n int) Condition {
+	return func(s *selenium.Session) (bool, error) {
+		return len(s.FindElements(selector)) == n, nil
+	}
+}
+
+// Staleness reports whether e is no longer attached to the DOM, per the W3C
+// "stale element reference" error (see Element.IsStale).
+func Staleness(e *selenium.Element) Condition {
+	return func(*selenium.Session) (bool, error) {
+		return e.IsStale(), nil
+	}
+}
+
+// TextToBePresent reports whether e's text contains substr.
+func TextToBePresent(e *selenium.Element, substr string) Condition {
+	return func(*selenium.Session) (bool, error) {
+		return strings.Contains(e.GetText(), substr), nil
+	}
+}
+
+// AlertPresent reports whether a JavaScript alert is currently open.
+func AlertPresent() Condition {
+	return func(s *selenium.Session) (bool, error) {
+		return s.IsAlertPresent(), nil
+	}
+}
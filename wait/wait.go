@@ -0,0 +1,146 @@
+// Package wait implements a typed Until(...) polling engine with composable
+// Conditions, mirroring Selenium's WebDriverWait / expected_conditions.
+package wait
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/theRealAlpaca/go-selenium"
+)
+
+// Condition reports whether the awaited state has been reached. A non-nil
+// error aborts the wait unless it is registered via IgnoreErrors.
+type Condition func(s *selenium.Session) (bool, error)
+
+const (
+	defaultTimeout      = 10 * time.Second
+	defaultPollInterval = 500 * time.Millisecond
+)
+
+type options struct {
+	timeout       time.Duration
+	pollInterval  time.Duration
+	jitter        time.Duration
+	ignoredErrors []error
+}
+
+// WaitOption configures Until.
+type WaitOption func(*options)
+
+// WithTimeout overrides how long Until polls before giving up.
+func WithTimeout(timeout time.Duration) WaitOption {
+	return func(o *options) { o.timeout = timeout }
+}
+
+// WithPollInterval overrides how often Until re-evaluates the condition.
+func WithPollInterval(interval time.Duration) WaitOption {
+	return func(o *options) { o.pollInterval = interval }
+}
+
+// WithJitter adds a random extra delay in [0, jitter) to every poll, to
+// avoid multiple waiters hammering the driver in lockstep.
+func WithJitter(jitter time.Duration) WaitOption {
+	return func(o *options) { o.jitter = jitter }
+}
+
+// IgnoreErrors keeps Until polling when the condition returns one of the
+// given errors instead of aborting, mirroring Selenium's
+// `ignored_exceptions`.
+func IgnoreErrors(errs ...error) WaitOption {
+	return func(o *options) { o.ignoredErrors = append(o.ignoredErrors, errs...) }
+}
+
+// Until polls cond against s until it reports true, returns an
+// unignored error, or the configured timeout elapses.
+func Until(s *selenium.Session, cond Condition, opts ...WaitOption) error {
+	o := options{timeout: defaultTimeout, pollInterval: defaultPollInterval}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	deadline := time.Now().Add(o.timeout)
+
+	for {
+		ok, err := cond(s)
+		if err != nil && !isIgnored(err, o.ignoredErrors) {
+			return errors.Wrap(err, "wait condition returned an error")
+		}
+
+		if ok {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return errors.Errorf("condition not met within %s", o.timeout)
+		}
+
+		time.Sleep(o.pollInterval + jitterDelay(o.jitter)) //nolint:gosec
+	}
+}
+
+func jitterDelay(jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(jitter))) //nolint:gosec
+}
+
+func isIgnored(err error, ignored []error) bool {
+	for _, target := range ignored {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// All combines conditions so the result is true only once every one of them
+// is true.
+func All(conds ...Condition) Condition {
+	return func(s *selenium.Session) (bool, error) {
+		for _, cond := range conds {
+			ok, err := cond(s)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+
+		return true, nil
+	}
+}
+
+// Any combines conditions so the result is true as soon as one of them is
+// true.
+func Any(conds ...Condition) Condition {
+	return func(s *selenium.Session) (bool, error) {
+		for _, cond := range conds {
+			ok, err := cond(s)
+			if err != nil {
+				return false, err
+			}
+
+			if ok {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+}
+
+// Not inverts cond.
+func Not(cond Condition) Condition {
+	return func(s *selenium.Session) (bool, error) {
+		ok, err := cond(s)
+		if err != nil {
+			return false, err
+		}
+
+		return !ok, nil
+	}
+}
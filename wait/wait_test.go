@@ -0,0 +1,100 @@
+package wait
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/theRealAlpaca/go-selenium"
+)
+
+func TestJitterDelay(t *testing.T) {
+	if got := jitterDelay(0); got != 0 {
+		t.Errorf("jitterDelay(0) = %v, want 0", got)
+	}
+
+	if got := jitterDelay(-time.Second); got != 0 {
+		t.Errorf("jitterDelay(negative) = %v, want 0", got)
+	}
+
+	for i := 0; i < 100; i++ {
+		got := jitterDelay(10 * time.Millisecond)
+		if got < 0 || got >= 10*time.Millisecond {
+			t.Fatalf("jitterDelay(10ms) = %v, want [0, 10ms)", got)
+		}
+	}
+}
+
+func TestIsIgnored(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+
+	if isIgnored(errA, nil) {
+		t.Error("isIgnored(errA, nil) = true, want false")
+	}
+
+	if !isIgnored(errA, []error{errB, errA}) {
+		t.Error("isIgnored(errA, [errB, errA]) = false, want true")
+	}
+
+	if isIgnored(errA, []error{errB}) {
+		t.Error("isIgnored(errA, [errB]) = true, want false")
+	}
+
+	wrapped := errors.Wrap(errA, "context")
+	if !isIgnored(wrapped, []error{errA}) {
+		t.Error("isIgnored(wrapped errA, [errA]) = false, want true")
+	}
+}
+
+func cond(ok bool, err error) Condition {
+	return func(*selenium.Session) (bool, error) { return ok, err }
+}
+
+func TestAll(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	if ok, err := All(cond(true, nil), cond(true, nil))(nil); !ok || err != nil {
+		t.Errorf("All(true, true) = (%v, %v), want (true, nil)", ok, err)
+	}
+
+	if ok, err := All(cond(true, nil), cond(false, nil))(nil); ok || err != nil {
+		t.Errorf("All(true, false) = (%v, %v), want (false, nil)", ok, err)
+	}
+
+	if ok, err := All(cond(true, errBoom), cond(true, nil))(nil); ok || !errors.Is(err, errBoom) { //nolint:lll
+		t.Errorf("All short-circuits on error = (%v, %v), want (false, boom)", ok, err) //nolint:lll
+	}
+}
+
+func TestAny(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	if ok, err := Any(cond(false, nil), cond(true, nil))(nil); !ok || err != nil {
+		t.Errorf("Any(false, true) = (%v, %v), want (true, nil)", ok, err)
+	}
+
+	if ok, err := Any(cond(false, nil), cond(false, nil))(nil); ok || err != nil {
+		t.Errorf("Any(false, false) = (%v, %v), want (false, nil)", ok, err)
+	}
+
+	if ok, err := Any(cond(false, errBoom), cond(true, nil))(nil); ok || !errors.Is(err, errBoom) { //nolint:lll
+		t.Errorf("Any propagates error = (%v, %v), want (false, boom)", ok, err)
+	}
+}
+
+func TestNot(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	if ok, err := Not(cond(true, nil))(nil); ok || err != nil {
+		t.Errorf("Not(true) = (%v, %v), want (false, nil)", ok, err)
+	}
+
+	if ok, err := Not(cond(false, nil))(nil); !ok || err != nil {
+		t.Errorf("Not(false) = (%v, %v), want (true, nil)", ok, err)
+	}
+
+	if _, err := Not(cond(false, errBoom))(nil); !errors.Is(err, errBoom) {
+		t.Errorf("Not propagates error = %v, want boom", err)
+	}
+}